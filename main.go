@@ -2,10 +2,11 @@ package main
 
 import (
 	"github.com/gorilla/websocket"
-	"github.com/qbeon/webwire-go"
+	webwire "github.com/qbeon/webwire-go"
+	"github.com/qbeon/webwire-go/transport/gorilla"
 )
 
 func main() {
 	conn, _, _ := websocket.NewClient(nil, nil, nil, 0, 0)
-	_ = webwire.NewClientAgent(conn, "", nil)
+	_ = webwire.NewClientAgent(gorilla.WrapConn(conn), "", nil)
 }
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/qbeon/webwire-go/transport"
+	"github.com/qbeon/webwire-go/transport/gorilla"
+)
+
+// defaultRequestTimeout is used when Options.DefaultRequestTimeout is left
+// zero.
+const defaultRequestTimeout = 10 * time.Second
+
+// Client is a webwire client connected (or about to connect) to a single
+// server address.
+type Client struct {
+	serverAddr string
+
+	connectLock sync.Mutex
+	transport   transport.Transport
+
+	statusManager *statusManager
+
+	impl Implementation
+
+	sessionLock       sync.RWMutex
+	session           *Session
+	sessionInfoParser SessionInfoParser
+
+	requests              *requestManager
+	defaultRequestTimeout time.Duration
+
+	errorLog   *log.Logger
+	warningLog *log.Logger
+}
+
+// NewClient creates a new client for the given server address. The client
+// isn't connected until Connect is called.
+func NewClient(serverAddr string, opts Options) *Client {
+	trans := opts.Transport
+	if trans == nil {
+		trans = gorilla.NewTransport()
+	}
+
+	var impl Implementation = opts.Implementation
+	if impl == nil {
+		impl = hooksAdapter{hooks: opts.Hooks}
+	}
+
+	sessionInfoParser := opts.SessionInfoParser
+	if sessionInfoParser == nil {
+		sessionInfoParser = defaultSessionInfoParser
+	}
+
+	timeout := opts.DefaultRequestTimeout
+	if timeout <= 0 {
+		timeout = defaultRequestTimeout
+	}
+
+	return &Client{
+		serverAddr:            serverAddr,
+		transport:             trans,
+		statusManager:         newStatusManager(opts.Autoconnect, nil),
+		impl:                  impl,
+		sessionInfoParser:     sessionInfoParser,
+		requests:              newRequestManager(),
+		defaultRequestTimeout: timeout,
+		errorLog:              log.New(os.Stderr, "webwire client error: ", log.LstdFlags),
+		warningLog:            log.New(os.Stderr, "webwire client warning: ", log.LstdFlags),
+	}
+}
+
+// Connect establishes the connection to the server, restoring the current
+// session if there is any. If the attempt fails while autoconnect is enabled,
+// the error is returned and the client keeps retrying in the background.
+func (clt *Client) Connect() error {
+	err := clt.connect()
+	if err != nil && clt.statusManager.Autoconnect() == AutoconnectEnabled {
+		go func() { _ = clt.tryAutoconnect(1) }()
+	}
+	return err
+}
+
+// Close disables the client, preventing any further autoconnect attempts,
+// and closes the current connection, if any.
+func (clt *Client) Close() {
+	clt.statusManager.setAutoconnect(AutoconnectDisabled)
+	_ = clt.transport.Close()
+}
+
+// Session returns the client's current session, or nil if there is none.
+func (clt *Client) Session() *Session {
+	clt.sessionLock.RLock()
+	defer clt.sessionLock.RUnlock()
+	return clt.session
+}
+
+// close performs the cleanup run after the reader goroutine returns.
+func (clt *Client) close() {
+	_ = clt.transport.Close()
+}
+
+// verifyProtocolVersion is the protocol compatibility check connect runs
+// before dialing. It performs no check and always returns nil.
+func (clt *Client) verifyProtocolVersion() error {
+	return nil
+}
+
+// tryAutoconnect retries connect in a backoff loop, starting at the given
+// attempt count, until it succeeds or autoconnect is disabled.
+func (clt *Client) tryAutoconnect(attempt int) error {
+	for {
+		if clt.statusManager.Autoconnect() != AutoconnectEnabled {
+			return nil
+		}
+		if err := clt.connect(); err == nil {
+			return nil
+		}
+		attempt++
+		time.Sleep(autoconnectBackoff(attempt))
+	}
+}
+
+// autoconnectBackoff returns the delay before the given reconnection
+// attempt, capped at 5 seconds.
+func autoconnectBackoff(attempt int) time.Duration {
+	delay := time.Duration(attempt) * 100 * time.Millisecond
+	if delay > 5*time.Second {
+		return 5 * time.Second
+	}
+	return delay
+}
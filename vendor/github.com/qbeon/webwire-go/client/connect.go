@@ -1,8 +1,6 @@
 package client
 
-import (
-	"sync/atomic"
-)
+import "github.com/qbeon/webwire-go/wwrerr"
 
 // connect will try to establish a connection to the configured webwire server
 // and try to automatically restore the session if there is any.
@@ -14,7 +12,7 @@ import (
 func (clt *Client) connect() error {
 	clt.connectLock.Lock()
 	defer clt.connectLock.Unlock()
-	if atomic.LoadInt32(&clt.status) == StatConnected {
+	if clt.statusManager.Status() == Connected {
 		return nil
 	}
 
@@ -22,7 +20,7 @@ func (clt *Client) connect() error {
 		return err
 	}
 
-	if err := clt.conn.Dial(clt.serverAddr); err != nil {
+	if err := clt.transport.Dial(clt.serverAddr); err != nil {
 		return err
 	}
 
@@ -30,25 +28,29 @@ func (clt *Client) connect() error {
 	go func() {
 		defer clt.close()
 		for {
-			message, err := clt.conn.Read()
+			message, err := clt.transport.Read()
 			if err != nil {
 				if err.IsAbnormalCloseErr() {
 					// Error while reading message
 					clt.errorLog.Print("Abnormal closure error:", err)
 				}
 
-				// Set status to disconnected if it wasn't disabled
-				if atomic.LoadInt32(&clt.status) == StatConnected {
-					atomic.StoreInt32(&clt.status, StatDisconnected)
-				}
+				// Transition to disconnected (a no-op if the status already is
+				// Disconnected; Close leaves the status to this transition)
+				clt.statusManager.setStatus(Disconnected)
+
+				// Fail every request still awaiting a reply instead of
+				// leaving it to time out, since none will ever arrive over
+				// this now-dead connection.
+				clt.requests.failAll(wwrerr.DisconnectedErr{Cause: err})
 
-				// Call hook
-				clt.hooks.OnDisconnected()
+				// Notify the implementation
+				clt.impl.OnDisconnected()
 
-				// Try to reconnect if the client wasn't disabled and autoconnect is on.
-				// reconnect in another goroutine to let this one die and free up the socket
+				// Try to reconnect if autoconnect is enabled. Reconnect in
+				// another goroutine to let this one die and free up the socket.
 				go func() {
-					if clt.autoconnect && atomic.LoadInt32(&clt.status) != StatDisabled {
+					if clt.statusManager.Autoconnect() == AutoconnectEnabled {
 						if err := clt.tryAutoconnect(0); err != nil {
 							clt.errorLog.Printf("Auto-reconnect failed after connection loss: %s", err)
 							return
@@ -64,7 +66,10 @@ func (clt *Client) connect() error {
 		}
 	}()
 
-	atomic.StoreInt32(&clt.status, StatConnected)
+	clt.statusManager.setStatus(Connected)
+
+	// Notify the implementation that the connection was (re-)established
+	clt.impl.OnConnected()
 
 	// Read the current sessions key if there is any
 	clt.sessionLock.RLock()
@@ -92,5 +97,7 @@ func (clt *Client) connect() error {
 	clt.sessionLock.Lock()
 	clt.session = restoredSession
 	clt.sessionLock.Unlock()
+
+	clt.impl.OnSessionCreated(restoredSession)
 	return nil
 }
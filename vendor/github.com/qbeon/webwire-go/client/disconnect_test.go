@@ -0,0 +1,52 @@
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	webwire "github.com/qbeon/webwire-go"
+	"github.com/qbeon/webwire-go/wwrerr"
+)
+
+// TestPendingRequestFailsWithDisconnectedErrOnTransportError verifies that a
+// request still awaiting a reply is failed with a wwrerr.DisconnectedErr,
+// checkable with errors.As, as soon as the reader goroutine observes a
+// transport read error - instead of being left to leak until it times out.
+func TestPendingRequestFailsWithDisconnectedErrOnTransportError(t *testing.T) {
+	trans := newFakeTransport()
+	clt := NewClient("ws://test", Options{
+		Transport:             trans,
+		DefaultRequestTimeout: 5 * time.Second,
+		Autoconnect:           AutoconnectDisabled,
+	})
+
+	if err := clt.Connect(); err != nil {
+		t.Fatalf("connect failed: %s", err)
+	}
+
+	done := make(chan struct{})
+	var reqErr error
+
+	go func() {
+		_, reqErr = clt.sendRequest("login", webwire.Payload{})
+		close(done)
+	}()
+
+	// Drain the outgoing request so sendRequest has registered its pending
+	// entry before the transport fails.
+	<-trans.outbox
+
+	trans.inbox <- fakeRead{err: &fakeTransportErr{abnormal: true, msg: "connection reset"}}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("sendRequest didn't return in time")
+	}
+
+	var disconnected wwrerr.DisconnectedErr
+	if !errors.As(reqErr, &disconnected) {
+		t.Fatalf("expected a wwrerr.DisconnectedErr, got %T: %s", reqErr, reqErr)
+	}
+}
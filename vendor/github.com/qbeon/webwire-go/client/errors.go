@@ -0,0 +1,32 @@
+package client
+
+import (
+	"errors"
+
+	"github.com/qbeon/webwire-go/wwrerr"
+)
+
+// Wire error codes carried by a wireMsgErrorReply, identifying which typed
+// wwrerr failure a request was rejected with.
+const (
+	errCodeSessionsDisabled    = "sessionsDisabled"
+	errCodeMaxSessConnsReached = "maxSessConnsReached"
+	errCodeSessionNotFound     = "sessionNotFound"
+)
+
+// errorFromWireCode translates a wire-level error code received in an
+// errorReply into the typed wwrerr failure it represents, so callers can
+// use errors.As instead of matching on opaque strings. Unrecognized codes
+// fall back to a wwrerr.ProtocolErr wrapping the server's error message.
+func errorFromWireCode(code string, message string) error {
+	switch code {
+	case errCodeSessionsDisabled:
+		return wwrerr.SessionsDisabledErr{}
+	case errCodeMaxSessConnsReached:
+		return wwrerr.MaxSessConnsReachedErr{}
+	case errCodeSessionNotFound:
+		return wwrerr.SessionNotFoundErr{}
+	default:
+		return wwrerr.ProtocolErr{Cause: errors.New(message)}
+	}
+}
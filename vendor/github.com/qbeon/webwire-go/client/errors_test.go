@@ -0,0 +1,96 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	webwire "github.com/qbeon/webwire-go"
+	"github.com/qbeon/webwire-go/wwrerr"
+)
+
+// sendRequestAndRejectWith drives a request through a fake transport and
+// resolves it with an errorReply carrying the given wire error code,
+// returning the error the caller received.
+func sendRequestAndRejectWith(t *testing.T, errCode string) error {
+	t.Helper()
+
+	trans := newFakeTransport()
+	clt := NewClient("ws://test", Options{
+		Transport:             trans,
+		DefaultRequestTimeout: time.Second,
+	})
+
+	done := make(chan struct{})
+	var reqErr error
+
+	go func() {
+		_, reqErr = clt.sendRequest("login", webwire.Payload{})
+		close(done)
+	}()
+
+	raw := <-trans.outbox
+
+	var req wireMessage
+	if err := json.Unmarshal(raw, &req); err != nil {
+		t.Fatalf("couldn't parse outgoing request: %s", err)
+	}
+
+	errReply, err := json.Marshal(wireMessage{
+		Type:      wireMsgErrorReply,
+		RequestID: req.RequestID,
+		ErrorCode: errCode,
+	})
+	if err != nil {
+		t.Fatalf("couldn't marshal error reply: %s", err)
+	}
+
+	if err := clt.handleMessage(errReply); err != nil {
+		t.Fatalf("handleMessage failed: %s", err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("sendRequest didn't return in time")
+	}
+
+	return reqErr
+}
+
+// TestHandleMessageTranslatesSessionsDisabledError verifies that an
+// errorReply carrying the "sessionsDisabled" wire code surfaces as a
+// wwrerr.SessionsDisabledErr, checkable with errors.As.
+func TestHandleMessageTranslatesSessionsDisabledError(t *testing.T) {
+	reqErr := sendRequestAndRejectWith(t, errCodeSessionsDisabled)
+
+	var sessionsDisabled wwrerr.SessionsDisabledErr
+	if !errors.As(reqErr, &sessionsDisabled) {
+		t.Fatalf("expected a wwrerr.SessionsDisabledErr, got %T: %s", reqErr, reqErr)
+	}
+}
+
+// TestHandleMessageTranslatesMaxSessConnsReachedError verifies that an
+// errorReply carrying the "maxSessConnsReached" wire code surfaces as a
+// wwrerr.MaxSessConnsReachedErr, checkable with errors.As.
+func TestHandleMessageTranslatesMaxSessConnsReachedError(t *testing.T) {
+	reqErr := sendRequestAndRejectWith(t, errCodeMaxSessConnsReached)
+
+	var maxSessConnsReached wwrerr.MaxSessConnsReachedErr
+	if !errors.As(reqErr, &maxSessConnsReached) {
+		t.Fatalf("expected a wwrerr.MaxSessConnsReachedErr, got %T: %s", reqErr, reqErr)
+	}
+}
+
+// TestHandleMessageTranslatesSessionNotFoundError verifies that an
+// errorReply carrying the "sessionNotFound" wire code surfaces as a
+// wwrerr.SessionNotFoundErr, checkable with errors.As.
+func TestHandleMessageTranslatesSessionNotFoundError(t *testing.T) {
+	reqErr := sendRequestAndRejectWith(t, errCodeSessionNotFound)
+
+	var sessionNotFound wwrerr.SessionNotFoundErr
+	if !errors.As(reqErr, &sessionNotFound) {
+		t.Fatalf("expected a wwrerr.SessionNotFoundErr, got %T: %s", reqErr, reqErr)
+	}
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"sync"
+
+	"github.com/qbeon/webwire-go/transport"
+)
+
+// fakeTransport is an in-memory transport.Transport used in tests to drive
+// the client without a real network connection, per the transport
+// abstraction's own rationale of enabling tests to inject a fake transport.
+type fakeTransport struct {
+	lock   sync.Mutex
+	closed bool
+	outbox chan []byte
+	inbox  chan fakeRead
+}
+
+// fakeRead is a single value fed back to a Read call.
+type fakeRead struct {
+	data []byte
+	err  transport.Error
+}
+
+func newFakeTransport() *fakeTransport {
+	return &fakeTransport{
+		outbox: make(chan []byte, 16),
+		inbox:  make(chan fakeRead, 16),
+	}
+}
+
+func (t *fakeTransport) Dial(serverAddr string) error { return nil }
+
+func (t *fakeTransport) Read() ([]byte, transport.Error) {
+	read, ok := <-t.inbox
+	if !ok {
+		return nil, &fakeTransportErr{abnormal: true, msg: "fake transport closed"}
+	}
+	return read.data, read.err
+}
+
+func (t *fakeTransport) Write(data []byte) error {
+	t.outbox <- data
+	return nil
+}
+
+func (t *fakeTransport) Close() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.closed {
+		return nil
+	}
+	t.closed = true
+	close(t.inbox)
+	return nil
+}
+
+// fakeTransportErr is a minimal transport.Error implementation for tests.
+type fakeTransportErr struct {
+	abnormal bool
+	msg      string
+}
+
+func (err *fakeTransportErr) Error() string { return err.msg }
+
+func (err *fakeTransportErr) IsAbnormalCloseErr() bool { return err.abnormal }
@@ -0,0 +1,13 @@
+package client
+
+// Hooks defines the legacy, narrow set of client callbacks.
+//
+// Deprecated: implement Implementation instead, which additionally covers
+// OnSignal, OnSessionCreated and OnConnected.
+type Hooks struct {
+	// OnSessionClosed is called when the server closed the current session.
+	OnSessionClosed func()
+
+	// OnDisconnected is called when the connection to the server was lost.
+	OnDisconnected func()
+}
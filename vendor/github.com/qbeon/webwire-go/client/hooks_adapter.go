@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+
+	webwire "github.com/qbeon/webwire-go"
+)
+
+// hooksAdapter adapts the legacy Hooks struct to the Implementation
+// interface, so the client always has a non-nil Implementation to call
+// into, whether the application configured Implementation, Hooks, or
+// neither. Hooks fields left nil are simply no-ops.
+type hooksAdapter struct {
+	hooks Hooks
+}
+
+func (a hooksAdapter) OnSignal(ctx context.Context, msg webwire.Message) {}
+
+func (a hooksAdapter) OnSessionCreated(session *Session) {}
+
+func (a hooksAdapter) OnSessionClosed() {
+	if a.hooks.OnSessionClosed != nil {
+		a.hooks.OnSessionClosed()
+	}
+}
+
+func (a hooksAdapter) OnDisconnected() {
+	if a.hooks.OnDisconnected != nil {
+		a.hooks.OnDisconnected()
+	}
+}
+
+func (a hooksAdapter) OnConnected() {}
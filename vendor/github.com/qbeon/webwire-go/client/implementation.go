@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+
+	webwire "github.com/qbeon/webwire-go"
+)
+
+// Implementation defines the interface implemented by client applications to
+// react to server-initiated events: inbound signals and session lifecycle
+// changes. It's passed once to NewClient through Options.Implementation and
+// supersedes the narrower, ad-hoc Hooks struct, which only exposed
+// OnSessionClosed and OnDisconnected.
+//
+// Hooks is retained for backwards compatibility and is deprecated; new client
+// applications should implement Implementation instead.
+type Implementation interface {
+	// OnSignal is called when the server sends a signal message.
+	OnSignal(ctx context.Context, msg webwire.Message)
+
+	// OnSessionCreated is called right after connect restored a session on
+	// this client. Sessions created on the server through
+	// ClientAgent.CreateSession are not reported to the client.
+	OnSessionCreated(session *Session)
+
+	// OnSessionClosed is called when the server closed the current
+	// session, via ClientAgent.CloseSession on the server side.
+	OnSessionClosed()
+
+	// OnDisconnected is called when the connection to the server was lost.
+	OnDisconnected()
+
+	// OnConnected is called once the connection to the server was
+	// (re-)established.
+	OnConnected()
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	webwire "github.com/qbeon/webwire-go"
+)
+
+// recordingImplementation records which callbacks were invoked, for use in
+// assertions.
+type recordingImplementation struct {
+	signals       chan webwire.Message
+	connected     chan struct{}
+	sessionClosed chan struct{}
+}
+
+func (impl *recordingImplementation) OnSignal(ctx context.Context, msg webwire.Message) {
+	impl.signals <- msg
+}
+
+func (impl *recordingImplementation) OnSessionCreated(session *Session) {}
+
+func (impl *recordingImplementation) OnSessionClosed() {
+	if impl.sessionClosed != nil {
+		impl.sessionClosed <- struct{}{}
+	}
+}
+
+func (impl *recordingImplementation) OnDisconnected() {}
+
+func (impl *recordingImplementation) OnConnected() {
+	impl.connected <- struct{}{}
+}
+
+// TestClientWithoutImplementationUsesHooksWithoutPanicking verifies that a
+// client configured with only the legacy Hooks (no Implementation) gets a
+// default Implementation that forwards to Hooks and never panics on a nil
+// interface, and that the forwarding actually happens.
+func TestClientWithoutImplementationUsesHooksWithoutPanicking(t *testing.T) {
+	sessionClosed := make(chan struct{}, 1)
+
+	trans := newFakeTransport()
+	clt := NewClient("ws://test", Options{
+		Transport: trans,
+		Hooks: Hooks{
+			OnSessionClosed: func() { sessionClosed <- struct{}{} },
+		},
+	})
+
+	// None of these must panic, even though no Implementation was set.
+	clt.impl.OnSignal(context.Background(), webwire.Message{})
+	clt.impl.OnConnected()
+	clt.impl.OnDisconnected()
+	clt.impl.OnSessionCreated(&Session{})
+	clt.impl.OnSessionClosed()
+
+	select {
+	case <-sessionClosed:
+	case <-time.After(time.Second):
+		t.Fatal("legacy Hooks.OnSessionClosed was not called through the default Implementation")
+	}
+}
+
+// TestClientConnectNotifiesImplementation verifies that connect() calls
+// OnConnected on a configured Implementation, and that handleMessage routes
+// an inbound signal to OnSignal.
+func TestClientConnectNotifiesImplementation(t *testing.T) {
+	trans := newFakeTransport()
+	impl := &recordingImplementation{
+		signals:   make(chan webwire.Message, 1),
+		connected: make(chan struct{}, 1),
+	}
+
+	clt := NewClient("ws://test", Options{
+		Transport:      trans,
+		Implementation: impl,
+	})
+
+	if err := clt.Connect(); err != nil {
+		t.Fatalf("connect failed: %s", err)
+	}
+
+	select {
+	case <-impl.connected:
+	case <-time.After(time.Second):
+		t.Fatal("OnConnected was not called")
+	}
+
+	signal, err := json.Marshal(wireMessage{
+		Type: wireMsgSignal,
+		Name: "test-signal",
+	})
+	if err != nil {
+		t.Fatalf("couldn't marshal signal: %s", err)
+	}
+
+	if err := clt.handleMessage(signal); err != nil {
+		t.Fatalf("handleMessage failed: %s", err)
+	}
+
+	select {
+	case msg := <-impl.signals:
+		if msg.Name != "test-signal" {
+			t.Fatalf("expected signal name %q, got %q", "test-signal", msg.Name)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("OnSignal was not called")
+	}
+
+	clt.Close()
+}
+
+// TestClientHandleMessageSessionClosed verifies that a wireMsgSessionClosed
+// message received from the server clears the client's session and
+// dispatches OnSessionClosed on the configured Implementation, proving the
+// session-closure path is actually wired up rather than merely declared.
+func TestClientHandleMessageSessionClosed(t *testing.T) {
+	trans := newFakeTransport()
+	impl := &recordingImplementation{
+		signals:       make(chan webwire.Message, 1),
+		connected:     make(chan struct{}, 1),
+		sessionClosed: make(chan struct{}, 1),
+	}
+
+	clt := NewClient("ws://test", Options{
+		Transport:      trans,
+		Implementation: impl,
+	})
+	clt.session = &Session{Key: "some-key"}
+
+	raw, err := json.Marshal(wireMessage{Type: wireMsgSessionClosed})
+	if err != nil {
+		t.Fatalf("couldn't marshal sessionClosed message: %s", err)
+	}
+
+	if err := clt.handleMessage(raw); err != nil {
+		t.Fatalf("handleMessage failed: %s", err)
+	}
+
+	select {
+	case <-impl.sessionClosed:
+	case <-time.After(time.Second):
+		t.Fatal("OnSessionClosed was not called")
+	}
+
+	if session := clt.Session(); session != nil {
+		t.Fatalf("expected session to be cleared, got %+v", session)
+	}
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	webwire "github.com/qbeon/webwire-go"
+	"github.com/qbeon/webwire-go/wwrerr"
+)
+
+// wireMessageType identifies the kind of message exchanged between client
+// and server over the transport.
+type wireMessageType string
+
+const (
+	wireMsgRequest       wireMessageType = "request"
+	wireMsgReply         wireMessageType = "reply"
+	wireMsgErrorReply    wireMessageType = "errorReply"
+	wireMsgSignal        wireMessageType = "signal"
+	wireMsgSessionClosed wireMessageType = "sessionClosed"
+)
+
+// wireMessage is the envelope every message sent or received over the
+// transport is encoded as.
+type wireMessage struct {
+	Type      wireMessageType `json:"type"`
+	RequestID string          `json:"requestId,omitempty"`
+	Name      string          `json:"name,omitempty"`
+	Payload   webwire.Payload `json:"payload,omitempty"`
+	ErrorCode string          `json:"errorCode,omitempty"`
+	ErrorMsg  string          `json:"errorMsg,omitempty"`
+}
+
+// handleMessage decodes a raw message received from the server and routes
+// it either to a pending request (reply) or to the Implementation (signal).
+func (clt *Client) handleMessage(raw []byte) error {
+	var msg wireMessage
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		return err
+	}
+
+	switch msg.Type {
+	case wireMsgReply:
+		clt.requests.fulfill(msg.RequestID, msg.Payload)
+
+	case wireMsgErrorReply:
+		clt.requests.fail(msg.RequestID, errorFromWireCode(msg.ErrorCode, msg.ErrorMsg))
+
+	case wireMsgSignal:
+		clt.impl.OnSignal(context.Background(), webwire.Message{
+			Name:    msg.Name,
+			Payload: msg.Payload,
+		})
+
+	case wireMsgSessionClosed:
+		clt.sessionLock.Lock()
+		clt.session = nil
+		clt.sessionLock.Unlock()
+		clt.impl.OnSessionClosed()
+
+	default:
+		return fmt.Errorf("unknown message type: %q", msg.Type)
+	}
+
+	return nil
+}
+
+// sendRequest sends a request message to the server and blocks until its
+// reply arrives or the request times out.
+func (clt *Client) sendRequest(name string, payload webwire.Payload) (webwire.Payload, error) {
+	id, pending := clt.requests.create()
+
+	raw, err := json.Marshal(wireMessage{
+		Type:      wireMsgRequest,
+		RequestID: id,
+		Name:      name,
+		Payload:   payload,
+	})
+	if err != nil {
+		return webwire.Payload{}, err
+	}
+
+	if err := clt.transport.Write(raw); err != nil {
+		return webwire.Payload{}, err
+	}
+
+	select {
+	case result := <-pending.result:
+		return result.payload, result.err
+	case <-time.After(clt.defaultRequestTimeout):
+		// Resolve (and remove) the pending entry ourselves, since no reply
+		// will ever arrive for it.
+		err := wwrerr.TimeoutErr{Cause: fmt.Errorf("request %q timed out", name)}
+		clt.requests.fail(id, err)
+		return webwire.Payload{}, err
+	}
+}
+
+// Request sends a request message to the server and returns its reply.
+func (clt *Client) Request(name string, payload webwire.Payload) (webwire.Payload, error) {
+	return clt.sendRequest(name, payload)
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"time"
+
+	"github.com/qbeon/webwire-go/transport"
+)
+
+// Options configures a Client instance created through NewClient.
+type Options struct {
+	// Hooks configures the legacy, narrow set of client callbacks.
+	//
+	// Deprecated: set Implementation instead.
+	Hooks Hooks
+
+	// Implementation receives server-push callbacks (signals, session
+	// lifecycle events, connection status). It supersedes Hooks.
+	Implementation Implementation
+
+	// Transport is the transport.Transport used to communicate with the
+	// server. Defaults to the gorilla/websocket transport if left nil.
+	Transport transport.Transport
+
+	// Autoconnect configures whether the client automatically (re)connects.
+	// Defaults to AutoconnectEnabled.
+	Autoconnect Autoconnect
+
+	// DefaultRequestTimeout is the timeout applied to requests. Defaults to
+	// 10 seconds if left zero.
+	DefaultRequestTimeout time.Duration
+
+	// SessionInfoParser parses the raw session info map received during
+	// session restoration into a typed SessionInfo value stored on
+	// Session.Info. Defaults to a parser that preserves the map as-is.
+	SessionInfoParser SessionInfoParser
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"strconv"
+	"sync"
+
+	webwire "github.com/qbeon/webwire-go"
+)
+
+// requestResult is the outcome of a request delivered to a pendingRequest.
+type requestResult struct {
+	payload webwire.Payload
+	err     error
+}
+
+// pendingRequest tracks an in-flight request awaiting its reply.
+type pendingRequest struct {
+	result chan requestResult
+}
+
+// requestManager tracks in-flight requests by ID and resolves them once a
+// reply or typed error arrives through handleMessage.
+type requestManager struct {
+	lock    sync.Mutex
+	pending map[string]*pendingRequest
+	nextID  uint64
+}
+
+func newRequestManager() *requestManager {
+	return &requestManager{pending: make(map[string]*pendingRequest)}
+}
+
+// create registers a new pending request and returns its ID.
+func (mgr *requestManager) create() (string, *pendingRequest) {
+	mgr.lock.Lock()
+	defer mgr.lock.Unlock()
+	mgr.nextID++
+	id := strconv.FormatUint(mgr.nextID, 10)
+	req := &pendingRequest{result: make(chan requestResult, 1)}
+	mgr.pending[id] = req
+	return id, req
+}
+
+// fulfill resolves the pending request identified by id with a successful
+// reply payload.
+func (mgr *requestManager) fulfill(id string, payload webwire.Payload) {
+	mgr.resolve(id, requestResult{payload: payload})
+}
+
+// fail resolves the pending request identified by id with a typed failure.
+func (mgr *requestManager) fail(id string, err error) {
+	mgr.resolve(id, requestResult{err: err})
+}
+
+// failAll resolves every currently pending request with err and clears the
+// map, so a lost connection doesn't leave requests awaiting a reply that
+// will never arrive until they eventually time out.
+func (mgr *requestManager) failAll(err error) {
+	mgr.lock.Lock()
+	pending := mgr.pending
+	mgr.pending = make(map[string]*pendingRequest)
+	mgr.lock.Unlock()
+
+	for _, req := range pending {
+		req.result <- requestResult{err: err}
+	}
+}
+
+func (mgr *requestManager) resolve(id string, result requestResult) {
+	mgr.lock.Lock()
+	req, ok := mgr.pending[id]
+	if ok {
+		delete(mgr.pending, id)
+	}
+	mgr.lock.Unlock()
+
+	if ok {
+		req.result <- result
+	}
+}
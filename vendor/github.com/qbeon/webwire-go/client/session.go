@@ -0,0 +1,13 @@
+package client
+
+// Session represents a client's session, as restored from, or created by,
+// the server.
+type Session struct {
+	// Key is the session's unique key, used to request its restoration on
+	// reconnection.
+	Key string
+
+	// Info holds the session's associated information, as returned by the
+	// configured SessionInfoParser.
+	Info SessionInfo
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"encoding/json"
+
+	webwire "github.com/qbeon/webwire-go"
+)
+
+// sessionRestorationRequestName is the reserved request name the server
+// recognizes as a session-restoration request.
+const sessionRestorationRequestName = ":restore-session:"
+
+// restoredSessionPayload is the wire representation of a session returned
+// by the server in response to a session-restoration request.
+type restoredSessionPayload struct {
+	Key  string                 `json:"key"`
+	Info map[string]interface{} `json:"info"`
+}
+
+// requestSessionRestoration asks the server to restore the session
+// identified by sessionKey, parsing the returned session info through the
+// client's configured SessionInfoParser so Session.Info carries the typed
+// value instead of a raw map.
+func (clt *Client) requestSessionRestoration(sessionKey []byte) (*Session, error) {
+	reply, err := clt.sendRequest(
+		sessionRestorationRequestName,
+		webwire.Payload{Data: sessionKey},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var restored restoredSessionPayload
+	if err := json.Unmarshal(reply.Data, &restored); err != nil {
+		return nil, err
+	}
+
+	return &Session{
+		Key:  restored.Key,
+		Info: clt.sessionInfoParser(restored.Info),
+	}, nil
+}
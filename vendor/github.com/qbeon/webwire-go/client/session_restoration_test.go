@@ -0,0 +1,152 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	webwire "github.com/qbeon/webwire-go"
+)
+
+// customSessionInfo is a typed stand-in for the raw session info map,
+// returned by a test SessionInfoParser.
+type customSessionInfo struct {
+	UserID string
+}
+
+// TestRequestSessionRestorationUsesSessionInfoParser verifies that
+// requestSessionRestoration parses the server's session info through the
+// client's configured SessionInfoParser, so Session.Info carries the typed
+// value instead of a raw map.
+func TestRequestSessionRestorationUsesSessionInfoParser(t *testing.T) {
+	trans := newFakeTransport()
+	clt := NewClient("ws://test", Options{
+		Transport: trans,
+		SessionInfoParser: func(info map[string]interface{}) SessionInfo {
+			userID, _ := info["userId"].(string)
+			return customSessionInfo{UserID: userID}
+		},
+		DefaultRequestTimeout: time.Second,
+	})
+
+	done := make(chan struct{})
+	var session *Session
+	var reqErr error
+
+	go func() {
+		session, reqErr = clt.requestSessionRestoration([]byte("some-key"))
+		close(done)
+	}()
+
+	raw := <-trans.outbox
+
+	var req wireMessage
+	if err := json.Unmarshal(raw, &req); err != nil {
+		t.Fatalf("couldn't parse outgoing request: %s", err)
+	}
+
+	replyPayload, err := json.Marshal(restoredSessionPayload{
+		Key:  "restored-key",
+		Info: map[string]interface{}{"userId": "u-1"},
+	})
+	if err != nil {
+		t.Fatalf("couldn't marshal reply payload: %s", err)
+	}
+
+	reply, err := json.Marshal(wireMessage{
+		Type:      wireMsgReply,
+		RequestID: req.RequestID,
+		Payload:   webwire.Payload{Data: replyPayload},
+	})
+	if err != nil {
+		t.Fatalf("couldn't marshal reply: %s", err)
+	}
+
+	if err := clt.handleMessage(reply); err != nil {
+		t.Fatalf("handleMessage failed: %s", err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("requestSessionRestoration didn't return in time")
+	}
+
+	if reqErr != nil {
+		t.Fatalf("requestSessionRestoration failed: %s", reqErr)
+	}
+
+	info, ok := session.Info.(customSessionInfo)
+	if !ok {
+		t.Fatalf("expected session.Info to be a customSessionInfo, got %T", session.Info)
+	}
+	if info.UserID != "u-1" {
+		t.Fatalf("expected UserID %q, got %q", "u-1", info.UserID)
+	}
+}
+
+// TestRequestSessionRestorationDefaultParserPreservesMap verifies that,
+// without a configured SessionInfoParser, Session.Info keeps behaving like
+// the previous raw map.
+func TestRequestSessionRestorationDefaultParserPreservesMap(t *testing.T) {
+	trans := newFakeTransport()
+	clt := NewClient("ws://test", Options{
+		Transport:             trans,
+		DefaultRequestTimeout: time.Second,
+	})
+
+	done := make(chan struct{})
+	var session *Session
+	var reqErr error
+
+	go func() {
+		session, reqErr = clt.requestSessionRestoration([]byte("some-key"))
+		close(done)
+	}()
+
+	raw := <-trans.outbox
+
+	var req wireMessage
+	if err := json.Unmarshal(raw, &req); err != nil {
+		t.Fatalf("couldn't parse outgoing request: %s", err)
+	}
+
+	replyPayload, err := json.Marshal(restoredSessionPayload{
+		Key:  "restored-key",
+		Info: map[string]interface{}{"userId": "u-1"},
+	})
+	if err != nil {
+		t.Fatalf("couldn't marshal reply payload: %s", err)
+	}
+
+	reply, err := json.Marshal(wireMessage{
+		Type:      wireMsgReply,
+		RequestID: req.RequestID,
+		Payload:   webwire.Payload{Data: replyPayload},
+	})
+	if err != nil {
+		t.Fatalf("couldn't marshal reply: %s", err)
+	}
+
+	if err := clt.handleMessage(reply); err != nil {
+		t.Fatalf("handleMessage failed: %s", err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("requestSessionRestoration didn't return in time")
+	}
+
+	if reqErr != nil {
+		t.Fatalf("requestSessionRestoration failed: %s", reqErr)
+	}
+
+	info, ok := session.Info.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected session.Info to be a map[string]interface{}, got %T", session.Info)
+	}
+	if info["userId"] != "u-1" {
+		t.Fatalf("expected userId %q, got %v", "u-1", info["userId"])
+	}
+}
@@ -0,0 +1,18 @@
+package client
+
+// SessionInfo represents the information associated with a session, as
+// returned by a SessionInfoParser. Applications that need a typed
+// representation (rather than the raw map deserialized from the wire)
+// implement a SessionInfoParser that returns their own concrete type.
+type SessionInfo interface{}
+
+// SessionInfoParser parses the raw session info map received from the server
+// during session restoration into a typed SessionInfo value that's stored on
+// Session.Info.
+type SessionInfoParser func(map[string]interface{}) SessionInfo
+
+// defaultSessionInfoParser is used when Options.SessionInfoParser isn't set.
+// It preserves the session info map as-is, matching the previous behavior.
+func defaultSessionInfoParser(info map[string]interface{}) SessionInfo {
+	return info
+}
@@ -0,0 +1,121 @@
+package client
+
+import "sync"
+
+// Status represents the connection status of the client.
+type Status int32
+
+const (
+	// Disconnected represents a client that's currently not connected to
+	// the server.
+	Disconnected Status = iota
+
+	// Connected represents a client that's currently connected to the
+	// server.
+	Connected
+)
+
+// Autoconnect represents whether the client automatically (re)establishes
+// the connection to the server whenever it's lost.
+type Autoconnect int32
+
+const (
+	// AutoconnectEnabled makes the client automatically (re)connect.
+	AutoconnectEnabled Autoconnect = iota
+
+	// AutoconnectDisabled makes the client never automatically (re)connect.
+	// It's set whenever the client is explicitly closed, overriding
+	// whatever Autoconnect was configured through Options.
+	AutoconnectDisabled
+)
+
+// statusChangeCallback is invoked whenever the client's status transitions
+// from one value to another.
+type statusChangeCallback func(old, new Status)
+
+// statusManager guards Status and Autoconnect behind a single mutex and
+// notifies subscribers of status transitions through a single guarded
+// entry point (setStatus), eliminating the previous race-prone pattern
+// where status was checked, then stored, then a hook was invoked as three
+// separate, unguarded steps.
+type statusManager struct {
+	lock        sync.Mutex
+	status      Status
+	autoconnect Autoconnect
+	onChange    statusChangeCallback
+}
+
+// newStatusManager creates a new status manager in the Disconnected status,
+// configured with the given initial autoconnect mode.
+func newStatusManager(autoconnect Autoconnect, onChange statusChangeCallback) *statusManager {
+	return &statusManager{
+		status:      Disconnected,
+		autoconnect: autoconnect,
+		onChange:    onChange,
+	}
+}
+
+// Status returns the current connection status.
+func (mgr *statusManager) Status() Status {
+	mgr.lock.Lock()
+	defer mgr.lock.Unlock()
+	return mgr.status
+}
+
+// Autoconnect returns the current autoconnect mode.
+func (mgr *statusManager) Autoconnect() Autoconnect {
+	mgr.lock.Lock()
+	defer mgr.lock.Unlock()
+	return mgr.autoconnect
+}
+
+// setAutoconnect sets the current autoconnect mode.
+func (mgr *statusManager) setAutoconnect(autoconnect Autoconnect) {
+	mgr.lock.Lock()
+	mgr.autoconnect = autoconnect
+	mgr.lock.Unlock()
+}
+
+// setStatus transitions to the given status and, if it actually changed,
+// invokes the subscribed callback. All state transitions must go through
+// this single guarded helper instead of checking and storing separately.
+func (mgr *statusManager) setStatus(new Status) {
+	mgr.lock.Lock()
+	old := mgr.status
+	if old == new {
+		mgr.lock.Unlock()
+		return
+	}
+	mgr.status = new
+	onChange := mgr.onChange
+	mgr.lock.Unlock()
+
+	if onChange != nil {
+		onChange(old, new)
+	}
+}
+
+// Status returns the client's current connection status.
+func (clt *Client) Status() Status {
+	return clt.statusManager.Status()
+}
+
+// Autoconnect returns the client's current autoconnect mode.
+func (clt *Client) Autoconnect() Autoconnect {
+	return clt.statusManager.Autoconnect()
+}
+
+// OnStatusChange subscribes the given callback to status transitions,
+// letting applications and tests observe connection state changes
+// deterministically instead of polling Status.
+func (clt *Client) OnStatusChange(callback func(old, new Status)) {
+	clt.statusManager.lock.Lock()
+	defer clt.statusManager.lock.Unlock()
+	previous := clt.statusManager.onChange
+	clt.statusManager.onChange = func(old, new Status) {
+		if previous != nil {
+			previous(old, new)
+		}
+		callback(old, new)
+	}
+}
@@ -0,0 +1,134 @@
+package webwire
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/qbeon/webwire-go/transport"
+)
+
+// ClientAgent represents a single client connection from the server's point
+// of view, communicating with the remote client over a pluggable
+// transport.Transport, exactly like the client package's Client does.
+type ClientAgent struct {
+	transport  transport.Transport
+	remoteAddr string
+	srv        *Server
+
+	sessionLock sync.Mutex
+	sessionKey  string
+}
+
+// NewClientAgent wraps an already established transport.Transport (such as
+// one obtained from upgrading an incoming HTTP connection) in a ClientAgent.
+func NewClientAgent(trans transport.Transport, remoteAddr string, srv *Server) *ClientAgent {
+	return &ClientAgent{
+		transport:  trans,
+		remoteAddr: remoteAddr,
+		srv:        srv,
+	}
+}
+
+// serve reads and dispatches messages sent by the remote client until the
+// transport is closed or a read fails.
+func (agent *ClientAgent) serve() {
+	defer agent.transport.Close()
+	for {
+		raw, err := agent.transport.Read()
+		if err != nil {
+			return
+		}
+
+		var msg wireMessage
+		if jsonErr := json.Unmarshal(raw, &msg); jsonErr != nil {
+			continue
+		}
+
+		if msg.Type == wireMsgRequest {
+			agent.handleRequest(msg)
+		}
+	}
+}
+
+// handleRequest invokes Hooks.OnRequest for an incoming request message and
+// writes back its reply or error.
+func (agent *ClientAgent) handleRequest(msg wireMessage) {
+	reply := wireMessage{Type: wireMsgReply, RequestID: msg.RequestID}
+
+	if agent.srv.opts.Hooks.OnRequest != nil {
+		ctx := context.WithValue(context.Background(), Msg, Message{
+			Name:    msg.Name,
+			Payload: msg.Payload,
+			Client:  agent,
+		})
+
+		payload, err := agent.srv.opts.Hooks.OnRequest(ctx)
+		if err != nil {
+			reply.Type = wireMsgErrReply
+			reply.ErrorMsg = err.Error()
+		} else {
+			reply.Payload = payload
+		}
+	}
+
+	raw, err := json.Marshal(reply)
+	if err != nil {
+		return
+	}
+	_ = agent.transport.Write(raw)
+}
+
+// CreateSession creates a new session for this client and fails if the
+// server has sessions disabled. The info argument is not stored with the
+// session.
+func (agent *ClientAgent) CreateSession(info SessionInfo) error {
+	if !agent.srv.opts.SessionsEnabled {
+		return errors.New("sessions are disabled on this server")
+	}
+
+	key, err := newSessionKey()
+	if err != nil {
+		return err
+	}
+
+	agent.sessionLock.Lock()
+	agent.sessionKey = key
+	agent.sessionLock.Unlock()
+
+	agent.srv.sessions.register(key, agent)
+	return nil
+}
+
+// CloseSession closes this client's current session, if any, and notifies
+// the remote client so its Implementation.OnSessionClosed is invoked.
+func (agent *ClientAgent) CloseSession() error {
+	agent.sessionLock.Lock()
+	key := agent.sessionKey
+	agent.sessionKey = ""
+	agent.sessionLock.Unlock()
+
+	if key == "" {
+		return nil
+	}
+
+	agent.srv.sessions.remove(key)
+
+	raw, err := json.Marshal(wireMessage{Type: wireMsgSessionClosed})
+	if err != nil {
+		return err
+	}
+	return agent.transport.Write(raw)
+}
+
+// newSessionKey generates a random, URL-safe session key.
+func newSessionKey() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
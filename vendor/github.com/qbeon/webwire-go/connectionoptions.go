@@ -0,0 +1,39 @@
+package webwire
+
+import "time"
+
+// ConnectionOptions is returned by Hooks.BeforeUpgrade and determines
+// whether an incoming connection is accepted, rejected, or delayed before
+// the HTTP-to-transport upgrade completes.
+type ConnectionOptions struct {
+	// Accepted determines whether the upgrade is allowed to proceed. It
+	// defaults to false, so a hook must explicitly accept a connection.
+	Accepted bool
+
+	// RejectionReason is sent to the client if Accepted is false.
+	RejectionReason string
+
+	// RejectionCode is the HTTP status code returned to the client if
+	// Accepted is false. Defaults to http.StatusForbidden if left zero.
+	RejectionCode int
+
+	// Delay postpones evaluating the upgrade by the given duration, useful
+	// for basic handshake rate-limiting.
+	Delay time.Duration
+}
+
+// Accept is a convenience constructor for a ConnectionOptions that accepts
+// the upgrade right away.
+func Accept() ConnectionOptions {
+	return ConnectionOptions{Accepted: true}
+}
+
+// Reject is a convenience constructor for a ConnectionOptions that rejects
+// the upgrade with the given reason and status code.
+func Reject(reason string, code int) ConnectionOptions {
+	return ConnectionOptions{
+		Accepted:        false,
+		RejectionReason: reason,
+		RejectionCode:   code,
+	}
+}
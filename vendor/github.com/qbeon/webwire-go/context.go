@@ -0,0 +1,11 @@
+package webwire
+
+// contextKey is the unexported type used for context keys defined by this
+// package, so they never collide with keys defined by other packages.
+type contextKey int
+
+const (
+	// Msg is the context.Context key under which Hooks.OnRequest finds the
+	// Message describing the request currently being handled.
+	Msg contextKey = iota
+)
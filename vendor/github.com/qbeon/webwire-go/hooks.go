@@ -0,0 +1,24 @@
+package webwire
+
+import (
+	"context"
+	"net/http"
+)
+
+// Hooks defines the set of server-side hooks a webwire server can
+// implement to react to the request and connection lifecycle.
+//
+// This vendor tree only carries the hooks exercised by the vendored client
+// pieces and tests; the full server implementation defines additional
+// hooks (OnSignal, OnClientConnected, ...) alongside these.
+type Hooks struct {
+	// OnRequest is called whenever the server receives a request message.
+	OnRequest func(ctx context.Context) (Payload, error)
+
+	// BeforeUpgrade is called right before an incoming HTTP connection is
+	// upgraded to a persistent connection, and decides whether to accept,
+	// reject, or delay the upgrade. It runs before session restoration
+	// takes place, which makes it the right place for rate-limiting
+	// handshakes or performing early auth/TLS validation.
+	BeforeUpgrade func(r *http.Request) ConnectionOptions
+}
@@ -0,0 +1,14 @@
+package webwire
+
+// Message represents an inbound message, such as a signal pushed by the
+// server or a request being handled, exposed to server-side hooks and the
+// client Implementation.
+type Message struct {
+	Name    string
+	Payload Payload
+
+	// Client identifies the ClientAgent that sent this message. It's nil
+	// for messages delivered to the client Implementation, since a client
+	// never needs a handle to itself.
+	Client *ClientAgent
+}
@@ -0,0 +1,7 @@
+package webwire
+
+// Payload represents a binary message payload exchanged between client and
+// server.
+type Payload struct {
+	Data []byte
+}
@@ -0,0 +1,72 @@
+package webwire
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+	"github.com/qbeon/webwire-go/transport/gorilla"
+)
+
+// Server represents a running webwire server instance, accepting incoming
+// HTTP connections and upgrading them to persistent, transport.Transport
+// backed connections managed through ClientAgent.
+type Server struct {
+	opts       ServerOptions
+	listener   net.Listener
+	httpServer *http.Server
+	upgrader   websocket.Upgrader
+	sessions   *sessionRegistry
+}
+
+// NewServer creates a new server listening on addr, configured with opts.
+// The server starts accepting connections immediately, in the background.
+func NewServer(addr string, opts ServerOptions) (*Server, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	srv := &Server{
+		opts:     opts,
+		listener: listener,
+		sessions: newSessionRegistry(),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", srv.handleUpgrade)
+	srv.httpServer = &http.Server{Handler: mux}
+
+	go srv.httpServer.Serve(listener)
+
+	return srv, nil
+}
+
+// Addr returns the websocket address the server is listening on.
+func (srv *Server) Addr() string {
+	return "ws://" + srv.listener.Addr().String()
+}
+
+// Shutdown gracefully stops the server, closing the listener and waiting
+// for in-flight requests to finish.
+func (srv *Server) Shutdown() error {
+	return srv.httpServer.Shutdown(context.Background())
+}
+
+// handleUpgrade handles an incoming HTTP connection, consulting
+// beforeUpgrade before upgrading it to a persistent connection managed by a
+// ClientAgent.
+func (srv *Server) handleUpgrade(w http.ResponseWriter, r *http.Request) {
+	if !srv.beforeUpgrade(w, r) {
+		return
+	}
+
+	conn, err := srv.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	agent := NewClientAgent(gorilla.WrapConn(conn), r.RemoteAddr, srv)
+	go agent.serve()
+}
@@ -0,0 +1,11 @@
+package webwire
+
+// ServerOptions configures a webwire server instance.
+type ServerOptions struct {
+	// SessionsEnabled enables session support on the server.
+	SessionsEnabled bool
+
+	// Hooks defines the server-side hooks invoked during the connection
+	// and request lifecycle.
+	Hooks Hooks
+}
@@ -0,0 +1,33 @@
+package webwire
+
+import "sync"
+
+// SessionInfo holds application-defined data associated with a session
+// created through ClientAgent.CreateSession.
+type SessionInfo interface{}
+
+// sessionRegistry tracks the ClientAgent currently holding each active
+// session, keyed by session key, mirroring the client package's
+// requestManager pattern for guarded map access.
+type sessionRegistry struct {
+	lock     sync.Mutex
+	sessions map[string]*ClientAgent
+}
+
+func newSessionRegistry() *sessionRegistry {
+	return &sessionRegistry{sessions: make(map[string]*ClientAgent)}
+}
+
+// register associates key with agent.
+func (reg *sessionRegistry) register(key string, agent *ClientAgent) {
+	reg.lock.Lock()
+	defer reg.lock.Unlock()
+	reg.sessions[key] = agent
+}
+
+// remove disassociates key from whichever agent currently holds it.
+func (reg *sessionRegistry) remove(key string) {
+	reg.lock.Lock()
+	defer reg.lock.Unlock()
+	delete(reg.sessions, key)
+}
@@ -0,0 +1,61 @@
+package test
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	webwire "github.com/qbeon/webwire-go"
+	webwireClient "github.com/qbeon/webwire-go/client"
+)
+
+// TestBeforeUpgradeAutoconnectRetry verifies that the client's autoconnect
+// mechanism keeps retrying the connection while BeforeUpgrade rejects the
+// upgrade, and eventually succeeds once BeforeUpgrade starts accepting it.
+func TestBeforeUpgradeAutoconnectRetry(t *testing.T) {
+	const rejectAttempts = 2
+	connected := NewPending(1, 2*time.Second, true)
+
+	var attempts int32
+
+	_, addr := setupServer(
+		t,
+		webwire.ServerOptions{
+			Hooks: webwire.Hooks{
+				BeforeUpgrade: func(r *http.Request) webwire.ConnectionOptions {
+					if atomic.AddInt32(&attempts, 1) <= rejectAttempts {
+						return webwire.Reject("rejected for test", http.StatusServiceUnavailable)
+					}
+					return webwire.Accept()
+				},
+			},
+		},
+	)
+
+	client := webwireClient.NewClient(
+		addr,
+		webwireClient.Options{
+			Autoconnect:           webwireClient.AutoconnectEnabled,
+			DefaultRequestTimeout: 2 * time.Second,
+		},
+	)
+
+	client.OnStatusChange(func(old, new webwireClient.Status) {
+		if new == webwireClient.Connected {
+			connected.Done()
+		}
+	})
+
+	if err := client.Connect(); err == nil {
+		t.Fatal("expected the first connection attempt to be rejected")
+	}
+
+	if err := connected.Wait(); err != nil {
+		t.Fatal("client never reconnected after BeforeUpgrade stopped rejecting")
+	}
+
+	if got := atomic.LoadInt32(&attempts); got <= rejectAttempts {
+		t.Fatalf("expected more than %d upgrade attempts, got %d", rejectAttempts, got)
+	}
+}
@@ -0,0 +1,58 @@
+package test
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// pending waits for a fixed number of events to occur within a timeout,
+// giving tests a deterministic way to synchronize with asynchronous
+// callbacks instead of sleeping.
+type pending struct {
+	lock          sync.Mutex
+	remaining     int
+	timeout       time.Duration
+	failOnTimeout bool
+	done          chan struct{}
+	closeOnce     sync.Once
+}
+
+// NewPending creates a pending waiting for count calls to Done, timing out
+// after timeout. If failOnTimeout is true, Wait returns an error when the
+// timeout elapses before count calls to Done were observed.
+func NewPending(count int, timeout time.Duration, failOnTimeout bool) *pending {
+	return &pending{
+		remaining:     count,
+		timeout:       timeout,
+		failOnTimeout: failOnTimeout,
+		done:          make(chan struct{}),
+	}
+}
+
+// Done marks one of the awaited events as having occurred.
+func (p *pending) Done() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.remaining == 0 {
+		return
+	}
+	p.remaining--
+	if p.remaining == 0 {
+		p.closeOnce.Do(func() { close(p.done) })
+	}
+}
+
+// Wait blocks until Done has been called count times or the timeout
+// elapses, in which case it returns an error if failOnTimeout is set.
+func (p *pending) Wait() error {
+	select {
+	case <-p.done:
+		return nil
+	case <-time.After(p.timeout):
+		if p.failOnTimeout {
+			return errors.New("timed out waiting for pending events")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,21 @@
+package test
+
+import (
+	"testing"
+
+	webwire "github.com/qbeon/webwire-go"
+)
+
+// setupServer starts a webwire server on an arbitrary free local port,
+// configured with opts, and registers its shutdown as a cleanup for t. It
+// returns the server and the address clients should connect to.
+func setupServer(t *testing.T, opts webwire.ServerOptions) (*webwire.Server, string) {
+	srv, err := webwire.NewServer("127.0.0.1:0", opts)
+	if err != nil {
+		t.Fatalf("Couldn't start server: %s", err)
+	}
+	t.Cleanup(func() {
+		srv.Shutdown()
+	})
+	return srv, srv.Addr()
+}
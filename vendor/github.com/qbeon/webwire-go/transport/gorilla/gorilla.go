@@ -0,0 +1,84 @@
+// Package gorilla provides the default webwire transport, implemented on
+// top of gorilla/websocket. It's the transport used by the client and the
+// server-side ClientAgent unless a different transport.Transport is
+// configured through Options.
+package gorilla
+
+import (
+	"github.com/gorilla/websocket"
+	"github.com/qbeon/webwire-go/transport"
+)
+
+// Transport is the default transport.Transport implementation, backed by a
+// gorilla/websocket connection.
+type Transport struct {
+	conn *websocket.Conn
+}
+
+// NewTransport creates a new, not yet connected gorilla-based transport.
+func NewTransport() *Transport {
+	return &Transport{}
+}
+
+// WrapConn wraps an already established gorilla/websocket connection (such
+// as the one obtained from upgrading an incoming HTTP request) in a
+// transport.Transport, for use on the server side where ClientAgent is
+// handed a connection rather than dialing one itself.
+func WrapConn(conn *websocket.Conn) *Transport {
+	return &Transport{conn: conn}
+}
+
+// Dial implements the transport.Transport interface.
+func (trans *Transport) Dial(serverAddr string) error {
+	conn, _, err := websocket.DefaultDialer.Dial(serverAddr, nil)
+	if err != nil {
+		return err
+	}
+	trans.conn = conn
+	return nil
+}
+
+// Read implements the transport.Transport interface.
+func (trans *Transport) Read() ([]byte, transport.Error) {
+	_, message, err := trans.conn.ReadMessage()
+	if err != nil {
+		return nil, &readError{cause: err}
+	}
+	return message, nil
+}
+
+// Write implements the transport.Transport interface.
+func (trans *Transport) Write(data []byte) error {
+	return trans.conn.WriteMessage(websocket.BinaryMessage, data)
+}
+
+// Close implements the transport.Transport interface.
+func (trans *Transport) Close() error {
+	if trans.conn == nil {
+		return nil
+	}
+	return trans.conn.Close()
+}
+
+// readError adapts a gorilla/websocket read error to transport.Error.
+type readError struct {
+	cause error
+}
+
+func (err *readError) Error() string {
+	return err.cause.Error()
+}
+
+// IsAbnormalCloseErr implements the transport.Error interface.
+//
+// IsUnexpectedCloseError returns true when the close code is NOT one of the
+// given codes, so the codes listed here must be the graceful ones - not
+// CloseAbnormalClosure, which is exactly the code gorilla/websocket
+// synthesizes for a dropped connection and must be reported as abnormal.
+func (err *readError) IsAbnormalCloseErr() bool {
+	return websocket.IsUnexpectedCloseError(
+		err.cause,
+		websocket.CloseNormalClosure,
+		websocket.CloseGoingAway,
+	)
+}
@@ -0,0 +1,37 @@
+// Package transport defines the abstraction used by the client and the
+// server-side ClientAgent to exchange data with a peer. It exists so the
+// core library doesn't hardcode a particular connection implementation
+// (such as gorilla/websocket) and can instead be configured with any
+// Transport that satisfies this interface.
+package transport
+
+// Transport abstracts a single, already (or about to be) established
+// connection to a peer. Implementations are free to use WebSocket, raw TCP,
+// QUIC, an in-memory pipe for testing, or anything else capable of moving
+// framed messages back and forth.
+type Transport interface {
+	// Dial establishes the connection to the given server address.
+	Dial(serverAddr string) error
+
+	// Read blocks until the next message frame is received, or returns an
+	// error describing why reading failed (including a regular or abnormal
+	// connection closure).
+	Read() ([]byte, Error)
+
+	// Write sends a single message frame to the peer.
+	Write(data []byte) error
+
+	// Close terminates the connection.
+	Close() error
+}
+
+// Error wraps a transport-level failure and classifies it, so callers can
+// tell an abnormal connection loss apart from a regular, expected closure.
+type Error interface {
+	error
+
+	// IsAbnormalCloseErr returns true if the error represents an abnormal
+	// closure of the connection (e.g. a dropped socket) rather than a
+	// regular, expected close.
+	IsAbnormalCloseErr() bool
+}
@@ -0,0 +1,33 @@
+package webwire
+
+import (
+	"net/http"
+	"time"
+)
+
+// beforeUpgrade consults Hooks.BeforeUpgrade, if configured, before an
+// incoming HTTP request is upgraded to a persistent connection. It returns
+// true if the upgrade should proceed, having already written a rejection
+// response to w otherwise.
+func (srv *Server) beforeUpgrade(w http.ResponseWriter, r *http.Request) bool {
+	if srv.opts.Hooks.BeforeUpgrade == nil {
+		return true
+	}
+
+	opts := srv.opts.Hooks.BeforeUpgrade(r)
+
+	if opts.Delay > 0 {
+		time.Sleep(opts.Delay)
+	}
+
+	if !opts.Accepted {
+		code := opts.RejectionCode
+		if code == 0 {
+			code = http.StatusForbidden
+		}
+		http.Error(w, opts.RejectionReason, code)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,27 @@
+package webwire
+
+// wireMessageType identifies the kind of message exchanged between the
+// server and a connected ClientAgent over the transport.
+type wireMessageType string
+
+const (
+	wireMsgRequest       wireMessageType = "request"
+	wireMsgReply         wireMessageType = "reply"
+	wireMsgErrReply      wireMessageType = "errorReply"
+	wireMsgSignal        wireMessageType = "signal"
+	wireMsgSessionClosed wireMessageType = "sessionClosed"
+)
+
+// wireMessage is the envelope every message sent or received over the
+// transport is encoded as. It mirrors the shape of the client package's own
+// wireMessage type, except that it has no errorCode field: error replies sent
+// by the server carry only errorMsg, which the client reports as a
+// wwrerr.ProtocolErr. The two only need to agree on JSON shape, since client
+// and server are conceptually separate processes.
+type wireMessage struct {
+	Type      wireMessageType `json:"type"`
+	RequestID string          `json:"requestId,omitempty"`
+	Name      string          `json:"name,omitempty"`
+	Payload   Payload         `json:"payload,omitempty"`
+	ErrorMsg  string          `json:"errorMsg,omitempty"`
+}
@@ -0,0 +1,80 @@
+// Package wwrerr provides a typed error hierarchy for webwire clients and
+// servers, replacing opaque errors and ad-hoc string matching with values
+// that callers can test for using errors.As and errors.Is.
+package wwrerr
+
+import "fmt"
+
+// DisconnectedErr indicates that the client is, or just became, disconnected
+// from the server. Cause is the underlying transport error that triggered
+// the disconnection, if any. The client sets it to the transport read error
+// that ended the connection, also when the client closed it itself.
+type DisconnectedErr struct {
+	Cause error
+}
+
+func (err DisconnectedErr) Error() string {
+	if err.Cause == nil {
+		return "client is disconnected"
+	}
+	return fmt.Sprintf("client is disconnected: %s", err.Cause)
+}
+
+// Unwrap gives access to the underlying transport error, if any.
+func (err DisconnectedErr) Unwrap() error {
+	return err.Cause
+}
+
+// SessionsDisabledErr indicates that the server has session support
+// disabled and therefore rejected a session-related request.
+type SessionsDisabledErr struct{}
+
+func (SessionsDisabledErr) Error() string {
+	return "sessions are disabled on the server"
+}
+
+// MaxSessConnsReachedErr indicates that the session has already reached the
+// maximum number of concurrent connections the server allows.
+type MaxSessConnsReachedErr struct{}
+
+func (MaxSessConnsReachedErr) Error() string {
+	return "maximum number of concurrent session connections reached"
+}
+
+// ProtocolErr indicates a violation of the webwire protocol, such as a
+// malformed or unexpected message.
+type ProtocolErr struct {
+	Cause error
+}
+
+func (err ProtocolErr) Error() string {
+	return fmt.Sprintf("protocol error: %s", err.Cause)
+}
+
+// Unwrap gives access to the underlying cause, if any.
+func (err ProtocolErr) Unwrap() error {
+	return err.Cause
+}
+
+// TimeoutErr indicates that an operation didn't complete within its
+// configured deadline.
+type TimeoutErr struct {
+	Cause error
+}
+
+func (err TimeoutErr) Error() string {
+	return fmt.Sprintf("timed out: %s", err.Cause)
+}
+
+// Unwrap gives access to the underlying cause, if any.
+func (err TimeoutErr) Unwrap() error {
+	return err.Cause
+}
+
+// SessionNotFoundErr indicates that the session a client tried to restore no
+// longer exists on the server.
+type SessionNotFoundErr struct{}
+
+func (SessionNotFoundErr) Error() string {
+	return "session not found"
+}